@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// businessdayHolidays holds the set of extra non-business dates (keyed by
+// "2006-01-02") that the businessday tag rejects, on top of Saturdays and
+// Sundays. It's guarded by businessdayHolidaysMu because SetBusinessdayHolidays
+// can be called while concurrent requests are validating through
+// validateBusinessDay. Configure it with SetBusinessdayHolidays.
+var (
+	businessdayHolidaysMu sync.RWMutex
+	businessdayHolidays   = map[string]bool{}
+)
+
+// SetBusinessdayHolidays replaces the holiday list consulted by the
+// businessday tag.
+func SetBusinessdayHolidays(holidays ...time.Time) {
+	next := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		next[h.Format("2006-01-02")] = true
+	}
+	businessdayHolidaysMu.Lock()
+	defer businessdayHolidaysMu.Unlock()
+	businessdayHolidays = next
+}
+
+// init registers this package's custom validation tags on Gin's default
+// validator engine, so importers pick them up just by importing the
+// package.
+func init() {
+	validate := binding.Validator.Engine().(*validator.Validate)
+	tags := map[string]validator.Func{
+		"bookabledate": validateBookableDate,
+		"pastdate":     validatePastDate,
+		"businessday":  validateBusinessDay,
+		"tzdatetime":   validateTZDatetime,
+	}
+	for tag, fn := range tags {
+		if err := validate.RegisterValidation(tag, fn); err != nil {
+			panic(fmt.Sprintf("customvalidators: register %s: %v", tag, err))
+		}
+	}
+}
+
+// validateBookableDate requires the field to be a time.Time on or after the
+// start of today.
+func validateBookableDate(fl validator.FieldLevel) bool {
+	t, ok := fieldTime(fl)
+	if !ok {
+		return false
+	}
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return !t.Before(today)
+}
+
+// validatePastDate requires the field to be a time.Time strictly before now.
+func validatePastDate(fl validator.FieldLevel) bool {
+	t, ok := fieldTime(fl)
+	if !ok {
+		return false
+	}
+	return t.Before(time.Now())
+}
+
+// validateBusinessDay requires the field to fall on a Monday through Friday
+// that isn't in businessdayHolidays.
+func validateBusinessDay(fl validator.FieldLevel) bool {
+	t, ok := fieldTime(fl)
+	if !ok {
+		return false
+	}
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false
+	}
+	businessdayHolidaysMu.RLock()
+	defer businessdayHolidaysMu.RUnlock()
+	return !businessdayHolidays[t.Format("2006-01-02")]
+}
+
+// validateTZDatetime implements tzdatetime=<layout>|<tz>: the field must
+// parse with the given layout in the given IANA time zone, rather than the
+// server's local zone.
+func validateTZDatetime(fl validator.FieldLevel) bool {
+	layout, tz, ok := splitTZDatetimeParam(fl.Param())
+	if !ok {
+		return false
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return false
+	}
+	_, err = time.ParseInLocation(layout, fl.Field().String(), loc)
+	return err == nil
+}
+
+func splitTZDatetimeParam(param string) (layout, tz string, ok bool) {
+	parts := strings.SplitN(param, "|", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// fieldTime extracts a time.Time from fl, dereferencing a *time.Time field
+// if necessary.
+func fieldTime(fl validator.FieldLevel) (time.Time, bool) {
+	field := fl.Field()
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return time.Time{}, false
+		}
+		field = field.Elem()
+	}
+	t, ok := field.Interface().(time.Time)
+	return t, ok
+}
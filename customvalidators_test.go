@@ -0,0 +1,69 @@
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// TestBusinessdayHolidaysConcurrentAccess exercises SetBusinessdayHolidays
+// racing against validateBusinessDay (via a real struct validation) from
+// many goroutines. It only asserts anything meaningful under `go test
+// -race`; businessdayHolidaysMu is what keeps this from being a data race.
+func TestBusinessdayHolidaysConcurrentAccess(t *testing.T) {
+	validate := binding.Validator.Engine().(*validator.Validate)
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			SetBusinessdayHolidays(time.Now().AddDate(0, 0, i))
+		}(i)
+		go func() {
+			defer wg.Done()
+			_ = validate.Struct(DateRange{Start: &start, AllowFuture: true})
+		}()
+	}
+	wg.Wait()
+
+	// Restore a clean slate for any test that runs after this one.
+	SetBusinessdayHolidays()
+}
+
+func TestValidateBusinessDayRejectsWeekend(t *testing.T) {
+	validate := validator.New()
+	if err := validate.RegisterValidation("businessday", validateBusinessDay); err != nil {
+		t.Fatalf("RegisterValidation failed: %v", err)
+	}
+
+	type s struct {
+		Day time.Time `validate:"businessday"`
+	}
+	saturday := time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC)
+	if err := validate.Struct(s{Day: saturday}); err == nil {
+		t.Fatalf("expected a Saturday to fail businessday")
+	}
+}
+
+func TestValidateBusinessDayRejectsHoliday(t *testing.T) {
+	validate := validator.New()
+	if err := validate.RegisterValidation("businessday", validateBusinessDay); err != nil {
+		t.Fatalf("RegisterValidation failed: %v", err)
+	}
+
+	holiday := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) // a Monday
+	SetBusinessdayHolidays(holiday)
+	defer SetBusinessdayHolidays()
+
+	type s struct {
+		Day time.Time `validate:"businessday"`
+	}
+	if err := validate.Struct(s{Day: holiday}); err == nil {
+		t.Fatalf("expected a configured holiday to fail businessday")
+	}
+}
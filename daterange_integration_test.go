@@ -0,0 +1,92 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+func fieldErrorTags(verrs validator.ValidationErrors) []string {
+	tags := make([]string, len(verrs))
+	for i, e := range verrs {
+		tags[i] = e.Tag()
+	}
+	return tags
+}
+
+// TestDateRangeDefaultIsRestrictive exercises DateRange through Gin's real,
+// fully-registered validator engine (not a bare validator.New(), which
+// never sees bookabledate/businessday because gin reads the "binding" tag
+// name). It documents the combined effect of chunk0-3's bookabledate tag on
+// Start and chunk0-5's AllowFuture default: a past Start and a future End
+// are both rejected unless the caller opts in to AllowFuture.
+func TestDateRangeDefaultIsRestrictive(t *testing.T) {
+	validate := binding.Validator.Engine().(*validator.Validate)
+
+	past := time.Now().AddDate(0, 0, -7)
+	future := time.Now().AddDate(0, 0, 7)
+
+	err := validate.Struct(DateRange{Start: &past, End: &future})
+	verrs, ok := err.(validator.ValidationErrors)
+	if !ok || len(verrs) == 0 {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+
+	tags := fieldErrorTags(verrs)
+	if !containsTag(tags, "bookabledate") {
+		t.Errorf("expected bookabledate to reject a past Start, got tags %v", tags)
+	}
+	if !containsTag(tags, "rangeinfuture") {
+		t.Errorf("expected rangeinfuture to reject a future End when AllowFuture is false, got tags %v", tags)
+	}
+}
+
+// TestDateRangeAllowFutureOnlyRelaxesEnd shows AllowFuture only lifts the
+// rangeinfuture check on End; a past Start is still rejected by
+// bookabledate regardless of AllowFuture.
+func TestDateRangeAllowFutureOnlyRelaxesEnd(t *testing.T) {
+	validate := binding.Validator.Engine().(*validator.Validate)
+
+	past := time.Now().AddDate(0, 0, -7)
+	future := time.Now().AddDate(0, 0, 7)
+
+	err := validate.Struct(DateRange{Start: &past, End: &future, AllowFuture: true})
+	verrs, ok := err.(validator.ValidationErrors)
+	if !ok || len(verrs) == 0 {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+
+	tags := fieldErrorTags(verrs)
+	if containsTag(tags, "rangeinfuture") {
+		t.Errorf("expected AllowFuture to suppress rangeinfuture, got tags %v", tags)
+	}
+	if !containsTag(tags, "bookabledate") {
+		t.Errorf("expected bookabledate to still reject a past Start with AllowFuture, got tags %v", tags)
+	}
+}
+
+// TestDateRangeRootHandlerWindowPasses pins down the one combination the
+// root handler's DateRange{AllowFuture: true} is meant to accept: a
+// bookable (today-or-later, weekday) Start before a future End.
+func TestDateRangeRootHandlerWindowPasses(t *testing.T) {
+	validate := binding.Validator.Engine().(*validator.Validate)
+
+	start := nextBusinessDayOnOrAfter(time.Now())
+	end := start.AddDate(0, 0, 1)
+	for end.Weekday() == time.Saturday || end.Weekday() == time.Sunday {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	if err := validate.Struct(DateRange{Start: &start, End: &end, AllowFuture: true}); err != nil {
+		t.Fatalf("expected a bookable Start before a future End to pass with AllowFuture, got %v", err)
+	}
+}
+
+func nextBusinessDayOnOrAfter(t time.Time) time.Time {
+	for t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// performRootGet replays the root handler's params binding and error path
+// (but not its DateRange binding or the diff computation, which aren't
+// relevant to these tests) through a real gin.Engine and httptest
+// request/response pair.
+func performRootGet(t *testing.T, target, acceptLanguage string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := gin.New()
+	r.GET("/", func(c *gin.Context) {
+		p := params{}
+		if err := c.ShouldBind(&p); err != nil {
+			writeProblem(c, http.StatusBadRequest, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if acceptLanguage != "" {
+		req.Header.Set("Accept-Language", acceptLanguage)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestWriteProblemSetsContentType(t *testing.T) {
+	w := performRootGet(t, "/", "")
+	if ct := w.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Fatalf("expected Content-Type %q, got %q", "application/problem+json", ct)
+	}
+}
+
+func TestWriteProblemShape(t *testing.T) {
+	w := performRootGet(t, "/", "")
+
+	var body problem
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if body.Status != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, body.Status)
+	}
+	if len(body.InvalidParams) == 0 {
+		t.Fatalf("expected invalid-params entries, got none")
+	}
+	for _, d := range body.InvalidParams {
+		if d.Pointer == "" {
+			t.Errorf("expected a non-empty pointer, got %+v", d)
+		}
+		if d.Code == "" {
+			t.Errorf("expected a non-empty code, got %+v", d)
+		}
+	}
+}
+
+// TestWriteProblemOmitsNilValue covers a missing required_without field
+// (Start is nil here), whose FieldError.Value() is a nil *string: the
+// "value" key must be omitted entirely, not serialized as the literal
+// string "<nil>".
+func TestWriteProblemOmitsNilValue(t *testing.T) {
+	w := performRootGet(t, "/", "")
+
+	var raw map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &raw); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	invalidParams, ok := raw["invalid-params"].([]any)
+	if !ok || len(invalidParams) == 0 {
+		t.Fatalf("expected invalid-params entries, got %v", raw)
+	}
+	for _, ip := range invalidParams {
+		detail, ok := ip.(map[string]any)
+		if !ok {
+			t.Fatalf("expected an object, got %T", ip)
+		}
+		if v, present := detail["value"]; present {
+			t.Errorf("expected value to be omitted for a nil field, got %v", v)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// TestParamsStructDoesNotPanic guards against the tzdatetime tag's "|"
+// separator being parsed as the tag-alternation operator, which previously
+// made every call to c.ShouldBind(&params{}) panic with "Undefined
+// validation function 'UTC'". https://github.com/go-playground/validator/issues/926
+func TestParamsStructDoesNotPanic(t *testing.T) {
+	validate := binding.Validator.Engine().(*validator.Validate)
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("validating params panicked: %v", r)
+		}
+	}()
+
+	err := validate.Struct(params{})
+	if err == nil {
+		t.Fatalf("expected required_without errors for an empty params, got nil")
+	}
+	if _, ok := err.(validator.ValidationErrors); !ok {
+		t.Fatalf("expected validator.ValidationErrors, got %T: %v", err, err)
+	}
+}
+
+func TestParamsStructValidUTCDatetime(t *testing.T) {
+	validate := binding.Validator.Engine().(*validator.Validate)
+	start := "2024-01-06T10:00:00Z"
+	if err := validate.Struct(params{Start: &start}); err != nil {
+		t.Fatalf("expected a valid UTC datetime to pass, got %v", err)
+	}
+}
+
+func TestParamsStructInvalidLayout(t *testing.T) {
+	validate := binding.Validator.Engine().(*validator.Validate)
+	start := "not-a-date"
+	if err := validate.Struct(params{Start: &start}); err == nil {
+		t.Fatalf("expected an invalid datetime to fail validation")
+	}
+}
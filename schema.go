@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FieldSchema describes one struct field's binding constraints, as exposed
+// by GET /schema/:model.
+type FieldSchema struct {
+	Name        string   `json:"name"`
+	Key         string   `json:"key"`
+	Type        string   `json:"type"`
+	Required    bool     `json:"required"`
+	RequiredIf  string   `json:"requiredIf,omitempty"`
+	Constraints []string `json:"constraints,omitempty"`
+}
+
+// ModelSchema is the reflected shape of a registered struct.
+type ModelSchema struct {
+	Name   string        `json:"name"`
+	Fields []FieldSchema `json:"fields"`
+}
+
+var (
+	schemaRegistryMu sync.RWMutex
+	schemaRegistry   = map[string]any{}
+)
+
+// RegisterSchema makes proto's shape available under name via GET
+// /schema/:model. proto should be a struct value (or pointer to one); only
+// its type is inspected.
+func RegisterSchema(name string, proto any) {
+	schemaRegistryMu.Lock()
+	defer schemaRegistryMu.Unlock()
+	schemaRegistry[name] = proto
+}
+
+func init() {
+	RegisterSchema("params", params{})
+	RegisterSchema("DateRange", DateRange{})
+}
+
+// DescribeSchema reflects proto's exported fields, and their binding tags,
+// into a ModelSchema.
+func DescribeSchema(name string, proto any) ModelSchema {
+	t := reflect.TypeOf(proto)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	schema := ModelSchema{Name: name}
+	for i := 0; i < t.NumField(); i++ {
+		field, ok := describeField(t.Field(i))
+		if !ok {
+			continue
+		}
+		schema.Fields = append(schema.Fields, field)
+	}
+	return schema
+}
+
+// describeField turns a single struct field's json/form and binding tags
+// into a FieldSchema. It returns ok=false for fields that aren't part of
+// the wire representation at all (json:"-", form:"-", or binding:"-"), so
+// Go-only config knobs like DateRange.MaxDuration don't show up in the
+// client-facing schema.
+func describeField(f reflect.StructField) (FieldSchema, bool) {
+	bindingTag := f.Tag.Get("binding")
+	jsonTag := f.Tag.Get("json")
+	formTag := f.Tag.Get("form")
+	if bindingTag == "-" || jsonTag == "-" || formTag == "-" {
+		return FieldSchema{}, false
+	}
+
+	key := f.Name
+	if jsonTag != "" {
+		key = strings.Split(jsonTag, ",")[0]
+	} else if formTag != "" {
+		key = strings.Split(formTag, ",")[0]
+	}
+
+	field := FieldSchema{
+		Name: f.Name,
+		Key:  key,
+		Type: f.Type.String(),
+	}
+
+	for _, tag := range strings.Split(bindingTag, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "omitempty" {
+			continue
+		}
+		name, param, _ := strings.Cut(tag, "=")
+		switch name {
+		case "required":
+			field.Required = true
+			continue
+		case "required_without":
+			field.RequiredIf = decodeValidatorEscapes(param)
+			continue
+		}
+		field.Constraints = append(field.Constraints, decodeValidatorEscapes(tag))
+	}
+
+	return field, true
+}
+
+// decodeValidatorEscapes reverses go-playground/validator's "0x2C"/"0x7C"
+// tag-param escapes (used in struct tags to embed a literal "," or "|"
+// without it being parsed as validator's own param/OR-alternation
+// delimiter - see tzdatetime's struct tag), so API consumers see the real
+// character instead of the escape hack.
+func decodeValidatorEscapes(s string) string {
+	s = strings.ReplaceAll(s, "0x2C", ",")
+	s = strings.ReplaceAll(s, "0x7C", "|")
+	return s
+}
+
+// schemaHandler serves GET /schema/:model, describing a previously
+// registered struct so clients can render forms or validate locally.
+func schemaHandler(c *gin.Context) {
+	name := c.Param("model")
+
+	schemaRegistryMu.RLock()
+	proto, ok := schemaRegistry[name]
+	schemaRegistryMu.RUnlock()
+
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("unknown model %q", name)})
+		return
+	}
+	c.JSON(http.StatusOK, DescribeSchema(name, proto))
+}
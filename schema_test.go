@@ -0,0 +1,71 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDescribeSchemaParams(t *testing.T) {
+	schema := DescribeSchema("params", params{})
+
+	if schema.Name != "params" {
+		t.Fatalf("expected name %q, got %q", "params", schema.Name)
+	}
+	if len(schema.Fields) != 2 {
+		t.Fatalf("expected 2 fields, got %d", len(schema.Fields))
+	}
+
+	start := schema.Fields[0]
+	if start.Key != "start" {
+		t.Errorf("expected key %q, got %q", "start", start.Key)
+	}
+	if start.Required {
+		t.Errorf("expected Start to not be unconditionally required")
+	}
+	if start.RequiredIf != "End" {
+		t.Errorf("expected requiredIf %q, got %q", "End", start.RequiredIf)
+	}
+	wantConstraints := []string{"tzdatetime=2006-01-02T15:04:05Z07:00|UTC"}
+	if !reflect.DeepEqual(start.Constraints, wantConstraints) {
+		t.Errorf("expected constraints %v, got %v", wantConstraints, start.Constraints)
+	}
+}
+
+func TestDescribeSchemaDateRange(t *testing.T) {
+	schema := DescribeSchema("DateRange", DateRange{})
+
+	// MaxDuration and AllowFuture are Go-only config knobs (binding:"-"),
+	// not part of the wire representation, so they must not appear here.
+	if len(schema.Fields) != 2 {
+		t.Fatalf("expected 2 fields, got %d: %+v", len(schema.Fields), schema.Fields)
+	}
+
+	start := schema.Fields[0]
+	if start.Key != "start" {
+		t.Errorf("expected key %q, got %q", "start", start.Key)
+	}
+	wantConstraints := []string{"bookabledate", "businessday"}
+	if !reflect.DeepEqual(start.Constraints, wantConstraints) {
+		t.Errorf("expected constraints %v, got %v", wantConstraints, start.Constraints)
+	}
+
+	end := schema.Fields[1]
+	if len(end.Constraints) != 0 {
+		t.Errorf("expected no constraints on End, got %v", end.Constraints)
+	}
+
+	for _, f := range schema.Fields {
+		if f.Name == "MaxDuration" || f.Name == "AllowFuture" {
+			t.Errorf("expected %s to be excluded from the schema, found %+v", f.Name, f)
+		}
+	}
+}
+
+func TestRegisterSchemaUnknownModel(t *testing.T) {
+	schemaRegistryMu.RLock()
+	_, ok := schemaRegistry["does-not-exist"]
+	schemaRegistryMu.RUnlock()
+	if ok {
+		t.Fatalf("expected no schema registered under %q", "does-not-exist")
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// dateRangeIssue is one cross-field problem found by dateRangeIssues, named
+// by the struct field it should be reported against and the tag a
+// validator.FieldError will surface for it.
+type dateRangeIssue struct {
+	Field string
+	Tag   string
+}
+
+// dateRangeIssues evaluates DateRange's cross-field rules. It is a plain
+// function, kept separate from validateDateRangeStruct, so the rules can be
+// exercised directly without going through the validator engine.
+func dateRangeIssues(dr DateRange) []dateRangeIssue {
+	var issues []dateRangeIssue
+	if dr.Start == nil || dr.End == nil {
+		return issues
+	}
+
+	if !dr.Start.Before(*dr.End) {
+		issues = append(issues, dateRangeIssue{Field: "Start", Tag: "startafterend"})
+	}
+	if dr.MaxDuration > 0 && dr.End.Sub(*dr.Start) > dr.MaxDuration {
+		issues = append(issues, dateRangeIssue{Field: "End", Tag: "rangetoolong"})
+	}
+	if !dr.AllowFuture && dr.End.After(time.Now()) {
+		issues = append(issues, dateRangeIssue{Field: "End", Tag: "rangeinfuture"})
+	}
+	return issues
+}
+
+// validateDateRangeStruct reports dateRangeIssues as field-level errors,
+// replacing the old lt|ltfield=End / gt|gtfield=Start tag combination.
+func validateDateRangeStruct(sl validator.StructLevel) {
+	dr := sl.Current().Interface().(DateRange)
+	for _, issue := range dateRangeIssues(dr) {
+		if issue.Field == "End" {
+			sl.ReportError(dr.End, issue.Field, issue.Field, issue.Tag, "")
+			continue
+		}
+		sl.ReportError(dr.Start, issue.Field, issue.Field, issue.Tag, "")
+	}
+}
+
+func init() {
+	validate := binding.Validator.Engine().(*validator.Validate)
+	validate.RegisterStructValidation(validateDateRangeStruct, DateRange{})
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func dateRangeIssueTags(issues []dateRangeIssue) []string {
+	tags := make([]string, len(issues))
+	for i, issue := range issues {
+		tags[i] = issue.Tag
+	}
+	return tags
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDateRangeIssuesMissingFields(t *testing.T) {
+	if issues := dateRangeIssues(DateRange{}); len(issues) != 0 {
+		t.Fatalf("expected no issues when Start/End are nil, got %v", issues)
+	}
+}
+
+func TestDateRangeIssuesStartAfterEnd(t *testing.T) {
+	start := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	issues := dateRangeIssues(DateRange{Start: &start, End: &end, AllowFuture: true})
+	if !containsTag(dateRangeIssueTags(issues), "startafterend") {
+		t.Fatalf("expected startafterend, got %v", issues)
+	}
+}
+
+func TestDateRangeIssuesExceedsMaxDuration(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(48 * time.Hour)
+	issues := dateRangeIssues(DateRange{Start: &start, End: &end, MaxDuration: 24 * time.Hour, AllowFuture: true})
+	if !containsTag(dateRangeIssueTags(issues), "rangetoolong") {
+		t.Fatalf("expected rangetoolong, got %v", issues)
+	}
+}
+
+func TestDateRangeIssuesWithinMaxDuration(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	issues := dateRangeIssues(DateRange{Start: &start, End: &end, MaxDuration: 24 * time.Hour, AllowFuture: true})
+	if containsTag(dateRangeIssueTags(issues), "rangetoolong") {
+		t.Fatalf("did not expect rangetoolong, got %v", issues)
+	}
+}
+
+func TestDateRangeIssuesFutureDisallowed(t *testing.T) {
+	start := time.Now()
+	end := start.Add(48 * time.Hour)
+	issues := dateRangeIssues(DateRange{Start: &start, End: &end})
+	if !containsTag(dateRangeIssueTags(issues), "rangeinfuture") {
+		t.Fatalf("expected rangeinfuture, got %v", issues)
+	}
+}
+
+func TestDateRangeIssuesFutureAllowed(t *testing.T) {
+	start := time.Now()
+	end := start.Add(48 * time.Hour)
+	issues := dateRangeIssues(DateRange{Start: &start, End: &end, AllowFuture: true})
+	if containsTag(dateRangeIssueTags(issues), "rangeinfuture") {
+		t.Fatalf("did not expect rangeinfuture, got %v", issues)
+	}
+}
+
+func TestDateRangeIssuesNoneWhenValid(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	issues := dateRangeIssues(DateRange{Start: &start, End: &end, AllowFuture: true})
+	if len(issues) != 0 {
+		t.Fatalf("expected no issues, got %v", issues)
+	}
+}
+
+// TestDateRangeTagRoundTrip proves dateRangeIssues' tag names survive the
+// validator.StructLevel plumbing unchanged, reaching FieldError.Tag() as-is
+// - unlike the old lt|ltfield=End combination this replaces, which arrived
+// at FieldError.Tag() as one mangled string
+// (https://github.com/go-playground/validator/issues/926).
+func TestDateRangeTagRoundTrip(t *testing.T) {
+	validate := validator.New()
+	validate.RegisterStructValidation(validateDateRangeStruct, DateRange{})
+
+	start := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := validate.Struct(DateRange{Start: &start, End: &end, AllowFuture: true})
+
+	verrs, ok := err.(validator.ValidationErrors)
+	if !ok || len(verrs) == 0 {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+	if tag := verrs[0].Tag(); tag != "startafterend" {
+		t.Fatalf("expected tag %q, got %q", "startafterend", tag)
+	}
+	if field := verrs[0].Field(); field != "Start" {
+		t.Fatalf("expected field %q, got %q", "Start", field)
+	}
+}
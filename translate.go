@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	en_locale "github.com/go-playground/locales/en"
+	fr_locale "github.com/go-playground/locales/fr"
+	zh_Hans_locale "github.com/go-playground/locales/zh_Hans"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	fr_translations "github.com/go-playground/validator/v10/translations/fr"
+	zh_translations "github.com/go-playground/validator/v10/translations/zh"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+// supportedLocales are the locales this package ships translations for, in
+// the order they were registered with the universal translator. "en" is
+// always the fallback.
+var supportedLocales = []string{"en", "fr", "zh_Hans"}
+
+// uni holds every registered locale's translator and is populated by init().
+var uni *ut.UniversalTranslator
+
+// customMessages carries the messages for tags the upstream
+// validator/v10/translations packages don't know about, because this
+// project hand-rolls them (required_without, the custom date tags in
+// customvalidators.go, and the DateRange struct-level tags in
+// structvalidation.go).
+var customMessages = map[string]map[string]string{
+	"en": {
+		"required_without": "{0} is required when {1} is not supplied",
+		"datetime":          "{0} must match the date/time format {1}",
+		"bookabledate":      "{0} must be today or later",
+		"pastdate":          "{0} must be in the past",
+		"businessday":       "{0} must fall on a business day",
+		"tzdatetime":        "{0} must match the date/time format {1}",
+		"startafterend":     "{0} must be before End",
+		"rangetoolong":      "the range between Start and {0} is too long",
+		"rangeinfuture":     "{0} must not be in the future",
+	},
+	"fr": {
+		"required_without": "{0} est requis lorsque {1} n'est pas fourni",
+		"datetime":          "{0} doit respecter le format de date/heure {1}",
+		"bookabledate":      "{0} doit être aujourd'hui ou une date ultérieure",
+		"pastdate":          "{0} doit être dans le passé",
+		"businessday":       "{0} doit être un jour ouvré",
+		"tzdatetime":        "{0} doit respecter le format de date/heure {1}",
+		"startafterend":     "{0} doit être antérieur à End",
+		"rangetoolong":      "l'intervalle entre Start et {0} est trop long",
+		"rangeinfuture":     "{0} ne doit pas être dans le futur",
+	},
+	"zh_Hans": {
+		"required_without": "未提供 {1} 时，{0} 为必填项",
+		"datetime":          "{0} 必须符合日期时间格式 {1}",
+		"bookabledate":      "{0} 必须是今天或之后的日期",
+		"pastdate":          "{0} 必须是过去的日期",
+		"businessday":       "{0} 必须是工作日",
+		"tzdatetime":        "{0} 必须符合日期时间格式 {1}",
+		"startafterend":     "{0} 必须早于 End",
+		"rangetoolong":      "Start 与 {0} 之间的间隔过长",
+		"rangeinfuture":     "{0} 不能是将来的日期",
+	},
+}
+
+func init() {
+	en := en_locale.New()
+	uni = ut.New(en, en, fr_locale.New(), zh_Hans_locale.New())
+
+	validate := binding.Validator.Engine().(*validator.Validate)
+
+	for _, locale := range supportedLocales {
+		trans, _ := uni.GetTranslator(locale)
+		switch locale {
+		case "en":
+			_ = en_translations.RegisterDefaultTranslations(validate, trans)
+		case "fr":
+			_ = fr_translations.RegisterDefaultTranslations(validate, trans)
+		case "zh_Hans":
+			_ = zh_translations.RegisterDefaultTranslations(validate, trans)
+		}
+		for tag, message := range customMessages[locale] {
+			_ = registerTagTranslation(validate, trans, tag, message)
+		}
+	}
+}
+
+// registerTagTranslation wires a single tag/message pair into validate for
+// the given translator.
+func registerTagTranslation(validate *validator.Validate, trans ut.Translator, tag, message string) error {
+	return validate.RegisterTranslation(tag, trans,
+		func(t ut.Translator) error {
+			return t.Add(tag, message, true)
+		},
+		func(t ut.Translator, fe validator.FieldError) string {
+			text, err := t.T(tag, fe.Field(), fe.Param())
+			if err != nil {
+				return fe.Error()
+			}
+			return text
+		},
+	)
+}
+
+// RegisterTranslation lets importers of this package add or override the
+// message used for tag in locale, e.g. to support a locale not shipped here
+// or to reword one of the built-in messages.
+func RegisterTranslation(locale, tag, message string) error {
+	trans, found := uni.GetTranslator(locale)
+	if !found {
+		return fmt.Errorf("translate: unknown locale %q", locale)
+	}
+	validate := binding.Validator.Engine().(*validator.Validate)
+	return registerTagTranslation(validate, trans, tag, message)
+}
+
+// negotiateTranslator picks a translator for the client's Accept-Language
+// header, honoring q weights (RFC 9110 12.5.4), and falls back to English
+// when nothing matches.
+func negotiateTranslator(acceptLanguage string) ut.Translator {
+	for _, tag := range parseAcceptLanguage(acceptLanguage) {
+		if locale := localeForTag(tag); locale != "" {
+			if trans, found := uni.GetTranslator(locale); found {
+				return trans
+			}
+		}
+	}
+	trans, _ := uni.GetTranslator("en")
+	return trans
+}
+
+// parseAcceptLanguage splits an Accept-Language header into its language
+// tags, sorted by descending q weight (default 1.0). Ties keep the header's
+// original order.
+func parseAcceptLanguage(acceptLanguage string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var weightedTags []weighted
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+
+		q := 1.0
+		if qStr, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(strings.TrimSpace(qStr), 64); err == nil {
+				q = parsed
+			}
+		}
+		weightedTags = append(weightedTags, weighted{tag: tag, q: q})
+	}
+
+	sort.SliceStable(weightedTags, func(i, j int) bool {
+		return weightedTags[i].q > weightedTags[j].q
+	})
+
+	tags := make([]string, len(weightedTags))
+	for i, wt := range weightedTags {
+		tags[i] = wt.tag
+	}
+	return tags
+}
+
+// localeForTag maps an RFC 5646 language tag (as seen in Accept-Language) to
+// one of supportedLocales.
+func localeForTag(tag string) string {
+	switch strings.ToLower(tag) {
+	case "zh", "zh-hans", "zh-cn", "zh-sg":
+		return "zh_Hans"
+	case "fr", "fr-fr", "fr-ca":
+		return "fr"
+	case "en", "en-us", "en-gb":
+		return "en"
+	default:
+		return ""
+	}
+}
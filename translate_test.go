@@ -0,0 +1,94 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAcceptLanguageSortsByQWeight(t *testing.T) {
+	got := parseAcceptLanguage("fr;q=0.2, en;q=0.9")
+	want := []string{"en", "fr"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestParseAcceptLanguageDefaultsQToOne(t *testing.T) {
+	got := parseAcceptLanguage("fr, en;q=0.9")
+	want := []string{"fr", "en"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestParseAcceptLanguageEmptyHeader(t *testing.T) {
+	if got := parseAcceptLanguage(""); len(got) != 0 {
+		t.Fatalf("expected no tags for an empty header, got %v", got)
+	}
+}
+
+func TestNegotiateTranslatorRespectsQWeight(t *testing.T) {
+	trans := negotiateTranslator("fr;q=0.2, en;q=0.9")
+	// Locale() isn't exposed directly; use a tag this package only
+	// translates differently in English vs French to tell them apart.
+	text, err := trans.T("pastdate", "Start")
+	if err != nil {
+		t.Fatalf("unexpected error translating: %v", err)
+	}
+	if text != "Start must be in the past" {
+		t.Fatalf("expected the higher-weighted English translation, got %q", text)
+	}
+}
+
+func TestNegotiateTranslatorFallsBackToEnglish(t *testing.T) {
+	trans := negotiateTranslator("de-DE, ja;q=0.8")
+	text, err := trans.T("pastdate", "Start")
+	if err != nil {
+		t.Fatalf("unexpected error translating: %v", err)
+	}
+	if text != "Start must be in the past" {
+		t.Fatalf("expected the English fallback, got %q", text)
+	}
+}
+
+func TestLocaleForTag(t *testing.T) {
+	cases := map[string]string{
+		"en":      "en",
+		"en-US":   "en",
+		"fr-CA":   "fr",
+		"zh-Hans": "zh_Hans",
+		"zh-CN":   "zh_Hans",
+		"de":      "",
+	}
+	for tag, want := range cases {
+		if got := localeForTag(tag); got != want {
+			t.Errorf("localeForTag(%q) = %q, want %q", tag, got, want)
+		}
+	}
+}
+
+func TestRegisterTranslationOverridesMessage(t *testing.T) {
+	if err := RegisterTranslation("en", "pastdate", "Start must be earlier than now"); err != nil {
+		t.Fatalf("RegisterTranslation failed: %v", err)
+	}
+	defer func() {
+		if err := RegisterTranslation("en", "pastdate", customMessages["en"]["pastdate"]); err != nil {
+			t.Fatalf("failed to restore original translation: %v", err)
+		}
+	}()
+
+	trans := negotiateTranslator("en")
+	text, err := trans.T("pastdate", "Start")
+	if err != nil {
+		t.Fatalf("unexpected error translating: %v", err)
+	}
+	if text != "Start must be earlier than now" {
+		t.Fatalf("expected the overridden message, got %q", text)
+	}
+}
+
+func TestRegisterTranslationUnknownLocale(t *testing.T) {
+	if err := RegisterTranslation("xx", "pastdate", "whatever"); err == nil {
+		t.Fatalf("expected an error for an unknown locale")
+	}
+}